@@ -0,0 +1,168 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/volumeattach"
+)
+
+func resourceBlockStorageVolumeAttachV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeAttachV1Create,
+		Read:   resourceBlockStorageVolumeAttachV1Read,
+		Delete: resourceBlockStorageVolumeAttachV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: envDefaultFunc("OS_REGION_NAME"),
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"device": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeAttachV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	volumeID := d.Get("volume_id").(string)
+
+	createOpts := &volumeattach.CreateOpts{
+		Device:   d.Get("device").(string),
+		VolumeID: volumeID,
+	}
+
+	blockStorageClient, err := config.blockStorageV1Client(d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	log.Printf("[INFO] Attaching volume %s to instance %s", volumeID, instanceID)
+	va, err := volumeattach.Create(computeClient, instanceID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error attaching OpenStack volume: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume (%s) to become in-use", volumeID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"attaching"},
+		Target:     "in-use",
+		Refresh:    VolumeV1StateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume (%s) to become in-use: %s",
+			volumeID, err)
+	}
+
+	// Store the ID now
+	d.SetId(fmt.Sprintf("%s/%s", volumeID, va.ID))
+
+	return resourceBlockStorageVolumeAttachV1Read(d, meta)
+}
+
+func resourceBlockStorageVolumeAttachV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	attachmentID := parseVolumeAttachmentID(d.Id())
+
+	va, err := volumeattach.Get(computeClient, instanceID, attachmentID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume attachment")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume attachment %s: %+v", d.Id(), va)
+
+	d.Set("instance_id", va.ServerID)
+	d.Set("volume_id", va.VolumeID)
+	d.Set("device", va.Device)
+	d.Set("region", d.Get("region").(string))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeAttachV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	blockStorageClient, err := config.blockStorageV1Client(d.Get("region").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	volumeID := d.Get("volume_id").(string)
+	attachmentID := parseVolumeAttachmentID(d.Id())
+
+	if err := volumeattach.Delete(computeClient, instanceID, attachmentID).ExtractErr(); err != nil {
+		return fmt.Errorf("Error detaching OpenStack volume: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"detaching"},
+		Target:     "available",
+		Refresh:    VolumeV1StateRefreshFunc(blockStorageClient, volumeID),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume (%s) to become available: %s",
+			volumeID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseVolumeAttachmentID extracts the attachment ID from a resource ID of
+// the form "volumeID/attachmentID".
+func parseVolumeAttachmentID(id string) string {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return id
+	}
+	return parts[1]
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/racker/perigee"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack/blockstorage/v1/volumes"
+	"github.com/rackspace/gophercloud/openstack/compute/v2/extensions/volumeattach"
 )
 
 func resourceBlockStorageVolumeV1() *schema.Resource {
@@ -41,6 +42,11 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -66,6 +72,31 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"force_detach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"attachment": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -78,14 +109,15 @@ func resourceBlockStorageVolumeV1Create(d *schema.ResourceData, meta interface{}
 	}
 
 	createOpts := &volumes.CreateOpts{
-		Description: d.Get("description").(string),
-		Name:        d.Get("name").(string),
-		Size:        d.Get("size").(int),
-		SnapshotID:  d.Get("snapshot_id").(string),
-		SourceVolID: d.Get("source_vol_id").(string),
-		ImageID:     d.Get("image_id").(string),
-		VolumeType:  d.Get("volume_type").(string),
-		Metadata:    resourceContainerMetadataV2(d),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		Description:      d.Get("description").(string),
+		Name:             d.Get("name").(string),
+		Size:             d.Get("size").(int),
+		SnapshotID:       d.Get("snapshot_id").(string),
+		SourceVolID:      d.Get("source_vol_id").(string),
+		ImageID:          d.Get("image_id").(string),
+		VolumeType:       d.Get("volume_type").(string),
+		Metadata:         resourceContainerMetadataV2(d),
 	}
 
 	log.Printf("[INFO] Requesting volume creation")
@@ -139,6 +171,16 @@ func resourceBlockStorageVolumeV1Read(d *schema.ResourceData, meta interface{})
 
 	d.Set("region", d.Get("region").(string))
 	d.Set("size", v.Size)
+	d.Set("availability_zone", v.AvailabilityZone)
+
+	attachments := make([]map[string]interface{}, len(v.Attachments))
+	for i, attachment := range v.Attachments {
+		attachments[i] = make(map[string]interface{})
+		attachments[i]["id"] = attachment["id"]
+		attachments[i]["instance_id"] = attachment["server_id"]
+		attachments[i]["device"] = attachment["device"]
+	}
+	d.Set("attachment", attachments)
 
 	if t, exists := d.GetOk("description"); exists && t != "" {
 		d.Set("description", v.Description)
@@ -210,6 +252,47 @@ func resourceBlockStorageVolumeV1Delete(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
+	v, err := volumes.Get(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume")
+	}
+
+	if d.Get("force_detach").(bool) && len(v.Attachments) > 0 {
+		computeClient, err := config.computeV2Client(d.Get("region").(string))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		for _, attachment := range v.Attachments {
+			serverID, ok := attachment["server_id"].(string)
+			if !ok {
+				continue
+			}
+			attachmentID, _ := attachment["id"].(string)
+
+			log.Printf("[INFO] Detaching volume %s from server %s", d.Id(), serverID)
+			err = volumeattach.Delete(computeClient, serverID, attachmentID).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error detaching OpenStack volume %s from server %s: %s", d.Id(), serverID, err)
+			}
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"detaching"},
+			Target:     "available",
+			Refresh:    VolumeV1StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    10 * time.Minute,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf(
+				"Error waiting for volume (%s) to become available: %s",
+				d.Id(), err)
+		}
+	}
+
 	err = volumes.Delete(blockStorageClient, d.Id()).ExtractErr()
 	if err != nil {
 		return fmt.Errorf("Error deleting OpenStack volume: %s", err)